@@ -0,0 +1,220 @@
+// Created by Johan Lee - March 2024
+
+/* This file implements the Adaptive Card format used by the Power Automate "Workflows for Teams"
+webhook flow, the replacement for the legacy Office 365 Connector MessageCard. An Adaptive Card is
+wrapped in a message envelope with a single attachment whose content is the actual card body. */
+
+package adaptiveCard
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Adaptive Card schema constants targeted by this package
+const (
+	AdaptiveCardSchema    = "http://adaptivecards.io/schemas/adaptive-card.json"
+	AdaptiveCardType      = "AdaptiveCard"
+	AdaptiveCardVersion   = "1.5"
+	attachmentContentType = "application/vnd.microsoft.card.adaptive"
+)
+
+// TextBlock is an Adaptive Card element that displays a run of text
+type TextBlock struct {
+	Type   string `json:"type"`
+	Text   string `json:"text"`
+	Size   string `json:"size,omitempty"`
+	Weight string `json:"weight,omitempty"`
+	Wrap   bool   `json:"wrap,omitempty"`
+}
+
+// Image is an Adaptive Card element that displays an image
+type Image struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// Fact is a single name/value pair displayed within a FactSet
+type Fact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+// FactSet is an Adaptive Card element that displays a series of facts in tabular form
+type FactSet struct {
+	Type  string `json:"type"`
+	Facts []Fact `json:"facts"`
+}
+
+// Container groups a set of elements together as a single Adaptive Card element
+type Container struct {
+	Type  string        `json:"type"`
+	Items []interface{} `json:"items"`
+}
+
+// Column is a single column within a ColumnSet
+type Column struct {
+	Type  string        `json:"type"`
+	Width string        `json:"width,omitempty"`
+	Items []interface{} `json:"items"`
+}
+
+// ColumnSet lays out a set of Columns side by side
+type ColumnSet struct {
+	Type    string   `json:"type"`
+	Columns []Column `json:"columns"`
+}
+
+// mentionEntity is the msteams.entity annotation Teams uses to resolve an <at> mention in Text
+type mentionEntity struct {
+	Type      string        `json:"type"`
+	Text      string        `json:"text"`
+	Mentioned mentionedUser `json:"mentioned"`
+}
+
+// mentionedUser identifies the account an entity mention resolves to
+type mentionedUser struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// msTeamsBlock carries Teams-specific extensions to the Adaptive Card schema, such as mentions
+type msTeamsBlock struct {
+	Entities []mentionEntity `json:"entities,omitempty"`
+}
+
+// cardContent is the body of the Adaptive Card, nested inside the attachment
+type cardContent struct {
+	Schema  string        `json:"$schema"`
+	Type    string        `json:"type"`
+	Version string        `json:"version"`
+	Body    []interface{} `json:"body"`
+	MSTeams *msTeamsBlock `json:"msteams,omitempty"`
+}
+
+// attachment wraps cardContent with the metadata Teams needs to render it
+type attachment struct {
+	ContentType string      `json:"contentType"`
+	ContentURL  interface{} `json:"contentUrl"`
+	Content     cardContent `json:"content"`
+}
+
+// AdaptiveCard is the top-level message envelope sent to a Power Automate "Workflows for Teams" webhook
+type AdaptiveCard struct {
+	Type        string       `json:"type"`
+	Attachments []attachment `json:"attachments"`
+
+	payload *bytes.Buffer `json:"-"`
+}
+
+// NewCard creates a new AdaptiveCard with an empty body, ready for the Add* builder methods
+func NewCard() *AdaptiveCard {
+	return &AdaptiveCard{
+		Type: "message",
+		Attachments: []attachment{
+			{
+				ContentType: attachmentContentType,
+				ContentURL:  nil,
+				Content: cardContent{
+					Schema:  AdaptiveCardSchema,
+					Type:    AdaptiveCardType,
+					Version: AdaptiveCardVersion,
+					Body:    []interface{}{},
+				},
+			},
+		},
+	}
+}
+
+// content returns the card's content block for the builder methods to mutate
+func (card *AdaptiveCard) content() *cardContent {
+	return &card.Attachments[0].Content
+}
+
+// AddTextBlock appends a TextBlock element to the card body
+func (card *AdaptiveCard) AddTextBlock(text, size, weight string) *AdaptiveCard {
+	content := card.content()
+	content.Body = append(content.Body, TextBlock{
+		Type:   "TextBlock",
+		Text:   text,
+		Size:   size,
+		Weight: weight,
+		Wrap:   true,
+	})
+	return card
+}
+
+// AddFactSet appends a FactSet element built from the given facts to the card body
+func (card *AdaptiveCard) AddFactSet(facts ...Fact) *AdaptiveCard {
+	content := card.content()
+	content.Body = append(content.Body, FactSet{
+		Type:  "FactSet",
+		Facts: facts,
+	})
+	return card
+}
+
+// AddImage appends an Image element to the card body
+func (card *AdaptiveCard) AddImage(url string) *AdaptiveCard {
+	content := card.content()
+	content.Body = append(content.Body, Image{
+		Type: "Image",
+		URL:  url,
+	})
+	return card
+}
+
+// AddContainer appends a Container element grouping the given items to the card body
+func (card *AdaptiveCard) AddContainer(items ...interface{}) *AdaptiveCard {
+	content := card.content()
+	content.Body = append(content.Body, Container{
+		Type:  "Container",
+		Items: items,
+	})
+	return card
+}
+
+// AddColumnSet appends a ColumnSet element laying out the given columns side by side to the card body
+func (card *AdaptiveCard) AddColumnSet(columns ...Column) *AdaptiveCard {
+	content := card.content()
+	content.Body = append(content.Body, ColumnSet{
+		Type:    "ColumnSet",
+		Columns: columns,
+	})
+	return card
+}
+
+// Validate performs validation for AdaptiveCard, checks the card body isn't empty
+func (card *AdaptiveCard) Validate() error {
+	if len(card.Attachments) == 0 || len(card.content().Body) == 0 {
+		return fmt.Errorf("invalid adaptive card: body required")
+	}
+	return nil
+}
+
+// Prepare handles the task to construct payload
+func (card *AdaptiveCard) Prepare() error {
+	jsonMessage, err := json.Marshal(card)
+	if err != nil {
+		return fmt.Errorf("error marshalling AdaptiveCard to JSON: %w", err)
+	}
+	if card.payload == nil {
+		card.payload = &bytes.Buffer{}
+	} else {
+		card.payload.Reset()
+	}
+
+	_, err = card.payload.Write(jsonMessage)
+	if err != nil {
+		return fmt.Errorf("error writing JSON for AdaptiveCard: %w", err)
+	}
+
+	return nil
+}
+
+// Payload returns the payload field, Prepare() should be called before this method
+func (card *AdaptiveCard) Payload() io.Reader {
+	return card.payload
+}
@@ -0,0 +1,48 @@
+// Created by Johan Lee - June 2024
+
+/* This file implements @mentions on AdaptiveCard via the msteams.entities block Teams uses to
+resolve "<at>Name</at>" markup in a TextBlock into a highlighted, actually-notifying mention. */
+
+package adaptiveCard
+
+import (
+	"strings"
+
+	"teams_listening_service/mention"
+)
+
+// AddMention tags a Teams user by appending "<at>Name</at>" to the most recently added TextBlock
+// (or a new one, if the card has none yet) and registering the corresponding msteams.entities
+// annotation
+func (card *AdaptiveCard) AddMention(m mention.Mention) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	tag := m.Tag()
+	content := card.content()
+
+	appended := false
+	for i := len(content.Body) - 1; i >= 0; i-- {
+		if block, ok := content.Body[i].(TextBlock); ok {
+			block.Text = strings.TrimSpace(block.Text + " " + tag)
+			content.Body[i] = block
+			appended = true
+			break
+		}
+	}
+	if !appended {
+		content.Body = append(content.Body, TextBlock{Type: "TextBlock", Text: tag, Wrap: true})
+	}
+
+	if content.MSTeams == nil {
+		content.MSTeams = &msTeamsBlock{}
+	}
+	content.MSTeams.Entities = append(content.MSTeams.Entities, mentionEntity{
+		Type:      "mention",
+		Text:      tag,
+		Mentioned: mentionedUser{ID: m.ID, Name: m.Name},
+	})
+
+	return nil
+}
@@ -0,0 +1,47 @@
+// Created by Johan Lee - June 2024
+
+/* This package provides the Mention type shared by the messageCard and adaptiveCard packages for
+tagging Teams users via msteams.entity annotations, so an on-call engineer actually gets alerted
+instead of just seeing plain, un-highlighted text. */
+
+package mention
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// ErrInvalidMention returns when a Mention's ID does not look like a UPN or an AAD object ID
+var ErrInvalidMention = errors.New("mention ID must be a UPN or a GUID")
+
+// upnPattern matches a user principal name, e.g. "jlee@contoso.com"
+var upnPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+// guidPattern matches an Azure AD object ID
+var guidPattern = regexp.MustCompile(`(?i)^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// Mention identifies a Teams user to tag in a card
+type Mention struct {
+	// ID is the mentioned user's Azure AD object ID (a GUID) or UPN
+	ID string
+
+	// Name is the display name rendered in the "<at>Name</at>" markup
+	Name string
+}
+
+// Validate checks that the Mention has a display name and that its ID looks like a UPN or a GUID
+func (m Mention) Validate() error {
+	if m.Name == "" {
+		return fmt.Errorf("invalid mention: name required")
+	}
+	if !upnPattern.MatchString(m.ID) && !guidPattern.MatchString(m.ID) {
+		return fmt.Errorf("%w: got %q", ErrInvalidMention, m.ID)
+	}
+	return nil
+}
+
+// Tag returns the "<at>Name</at>" markup Teams resolves into a highlighted mention
+func (m Mention) Tag() string {
+	return fmt.Sprintf("<at>%s</at>", m.Name)
+}
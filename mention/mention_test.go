@@ -0,0 +1,42 @@
+package mention
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMentionValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		mention    Mention
+		expectFail bool
+	}{
+		{"valid UPN", Mention{ID: "jlee@contoso.com", Name: "Johan Lee"}, false},
+		{"valid GUID", Mention{ID: "d3c08eb3-7c4c-4a01-bc01-6c67b56c8da9", Name: "Johan Lee"}, false},
+		{"missing name", Mention{ID: "jlee@contoso.com", Name: ""}, true},
+		{"malformed id", Mention{ID: "not-a-upn-or-guid", Name: "Johan Lee"}, true},
+		{"empty id", Mention{ID: "", Name: "Johan Lee"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.mention.Validate()
+			if tt.expectFail && err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			if tt.expectFail && !errors.Is(err, ErrInvalidMention) && tt.mention.Name != "" {
+				t.Fatalf("expected ErrInvalidMention, got: %v", err)
+			}
+			if !tt.expectFail && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestMentionTag(t *testing.T) {
+	m := Mention{ID: "jlee@contoso.com", Name: "Johan Lee"}
+	if got, want := m.Tag(), "<at>Johan Lee</at>"; got != want {
+		t.Fatalf("Tag() = %q, want %q", got, want)
+	}
+}
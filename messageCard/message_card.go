@@ -12,6 +12,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/url"
 )
 
 // MessageCard struct and fields
@@ -30,11 +31,24 @@ type MessageCard struct {
 	//Color of message card
 	Color string `json:"color,omitempty" yaml:"color,omitempty"`
 
+	//Sections, each rendered as its own block below the title/text
+	Sections []*MessageCardSection `json:"sections,omitempty" yaml:"sections,omitempty"`
+
+	//PotentialAction, actionable buttons shown below the card (e.g. OpenUri, HttpPOST)
+	PotentialAction []MessageCardPotentialAction `json:"potentialAction,omitempty" yaml:"potentialAction,omitempty"`
+
+	//Markdown controls whether Text is rendered as markdown, set by AddMention
+	Markdown bool `json:"markdown,omitempty" yaml:"markdown,omitempty"`
+
+	//Entities, msteams.entity annotations (currently just @mentions) resolved against Text
+	Entities []MessageCardEntity `json:"entities,omitempty" yaml:"entities,omitempty"`
+
 	//Payload, JSON format
 	payload *bytes.Buffer `json:"-" yaml:"-"`
 }
 
-// Validate performs validation for MessageCard, checks for Text field
+// Validate performs validation for MessageCard, checks for Text field as well as the optional
+// sections and potential actions
 func (card *MessageCard) Validate() error {
 	if card.Title == "" {
 		return fmt.Errorf("invalid message card: title required")
@@ -42,6 +56,26 @@ func (card *MessageCard) Validate() error {
 	if card.Text == "" {
 		return fmt.Errorf("invalid message card: text required")
 	}
+
+	for _, section := range card.Sections {
+		for _, fact := range section.Facts {
+			if fact.Name == "" || fact.Value == "" {
+				return fmt.Errorf("invalid message card: fact name and value required")
+			}
+		}
+	}
+
+	for _, action := range card.PotentialAction {
+		for _, target := range action.Targets {
+			if target.URI == "" {
+				return fmt.Errorf("invalid message card: action target uri required")
+			}
+			if _, err := url.ParseRequestURI(target.URI); err != nil {
+				return fmt.Errorf("invalid message card: malformed action target uri %q: %w", target.URI, err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,49 @@
+// Created by Johan Lee - June 2024
+
+/* This file implements @mentions on MessageCard via the msteams.entity annotation Teams uses to
+resolve "<at>Name</at>" markup into a highlighted, actually-notifying mention. */
+
+package messageCard
+
+import (
+	"strings"
+
+	"teams_listening_service/mention"
+)
+
+// MessageCardEntity is a msteams.entity annotation, currently only used for @mentions
+type MessageCardEntity struct {
+	Type      string               `json:"type"`
+	Text      string               `json:"text"`
+	Mentioned MessageCardMentioned `json:"mentioned"`
+}
+
+// MessageCardMentioned identifies the account an entity mention resolves to
+type MessageCardMentioned struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// AddMention tags a Teams user by appending "<at>Name</at>" to the card's Text, marking the
+// affected content as markdown, and registering the corresponding entities annotation Teams
+// needs to resolve and highlight the mention
+func (card *MessageCard) AddMention(m mention.Mention) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	tag := m.Tag()
+	card.Text = strings.TrimSpace(card.Text + " " + tag)
+
+	//The tag is only ever appended to the top-level Text, so the top-level card is always the
+	//container Teams needs to parse it as markdown, regardless of whether the card has sections.
+	card.Markdown = true
+
+	card.Entities = append(card.Entities, MessageCardEntity{
+		Type:      "mention",
+		Text:      tag,
+		Mentioned: MessageCardMentioned{ID: m.ID, Name: m.Name},
+	})
+
+	return nil
+}
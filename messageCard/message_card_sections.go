@@ -0,0 +1,96 @@
+// Created by Johan Lee - May 2024
+
+/* This file extends MessageCard with the rest of the Office 365 Connector card schema: sections
+(with facts and images) and potential actions (OpenUri, HttpPOST). These are what turn a plain
+title/text notification into a useful DevOps monitoring card with links back to build logs. */
+
+package messageCard
+
+// MessageCardSectionFact is a single name/value pair displayed within a section
+type MessageCardSectionFact struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// MessageCardSectionImage is a single image displayed within a section's Images list
+type MessageCardSectionImage struct {
+	Image string `json:"image"`
+	Title string `json:"title,omitempty"`
+}
+
+// MessageCardSection is one section of a MessageCard
+type MessageCardSection struct {
+	ActivityTitle    string `json:"activityTitle,omitempty"`
+	ActivitySubtitle string `json:"activitySubtitle,omitempty"`
+	ActivityImage    string `json:"activityImage,omitempty"`
+	ActivityText     string `json:"activityText,omitempty"`
+
+	Facts  []MessageCardSectionFact  `json:"facts,omitempty"`
+	Images []MessageCardSectionImage `json:"images,omitempty"`
+
+	HeroImage string `json:"heroImage,omitempty"`
+
+	//Markdown controls whether ActivityText/Text fields in this section are rendered as markdown
+	Markdown bool `json:"markdown,omitempty"`
+
+	//StartGroup draws a dividing line above this section when true
+	StartGroup bool `json:"startGroup,omitempty"`
+}
+
+// MessageCardActionTarget is a single OS-specific target for an OpenUri action
+type MessageCardActionTarget struct {
+	OS  string `json:"os"`
+	URI string `json:"uri"`
+}
+
+// MessageCardActionHeader is a single HTTP header sent with a HttpPOST action
+type MessageCardActionHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// MessageCardPotentialAction is an actionable element shown below a MessageCard. Targets is
+// populated for an OpenUri action; Target, Body and Headers are populated for a HttpPOST action.
+type MessageCardPotentialAction struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+
+	Targets []MessageCardActionTarget `json:"targets,omitempty"`
+
+	Target  string                    `json:"target,omitempty"`
+	Body    string                    `json:"body,omitempty"`
+	Headers []MessageCardActionHeader `json:"headers,omitempty"`
+}
+
+// AddSection appends a new, empty section to the card and returns it so the caller can continue
+// configuring it, e.g. card.AddSection().AddFact("Build", "#123")
+func (card *MessageCard) AddSection() *MessageCardSection {
+	section := &MessageCardSection{}
+	card.Sections = append(card.Sections, section)
+	return section
+}
+
+// AddFact appends a fact to the section
+func (section *MessageCardSection) AddFact(name, value string) *MessageCardSection {
+	section.Facts = append(section.Facts, MessageCardSectionFact{Name: name, Value: value})
+	return section
+}
+
+// AddImage appends an image to the section
+func (section *MessageCardSection) AddImage(image, title string) *MessageCardSection {
+	section.Images = append(section.Images, MessageCardSectionImage{Image: image, Title: title})
+	return section
+}
+
+// AddOpenURIAction appends an OpenUri PotentialAction to the card, linking out to the given uri,
+// e.g. build logs or a status page
+func (card *MessageCard) AddOpenURIAction(name, uri string) *MessageCard {
+	card.PotentialAction = append(card.PotentialAction, MessageCardPotentialAction{
+		Type: "OpenUri",
+		Name: name,
+		Targets: []MessageCardActionTarget{
+			{OS: "default", URI: uri},
+		},
+	})
+	return card
+}
@@ -18,12 +18,21 @@ import (
 	"time"
 )
 
-// Regex constant to validate the pattern of incoming webhook url provided by user
+// Regex constants to validate the pattern of incoming webhook urls provided by user. A TeamsClient
+// falls back to WebhookURLValidPattern alone when no patterns have been registered via
+// AddWebhookURLValidationPattern / SetWebhookURLValidationPatterns; the other patterns here are
+// only checked once registered.
 const (
 	WebhookURLValidPattern = `^https:\/\/(?:.*\.webhook|outlook)\.office(?:365)?\.com`
 
-	//Other URL regex constants can go here
+	// WebhookURLValidPatternGCC and WebhookURLValidPatternDE match the sovereign cloud variants of
+	// the legacy Office 365 Connector webhook host
+	WebhookURLValidPatternGCC = `^https:\/\/(?:.*\.webhook|outlook)\.office(?:365)?\.us`
+	WebhookURLValidPatternDE  = `^https:\/\/(?:.*\.webhook|outlook)\.office(?:365)?\.de`
 
+	// PowerAutomateWebhookURLPattern matches the Power Automate "Workflows for Teams" webhook hosts
+	// that are replacing the retiring Office 365 Connector webhooks
+	PowerAutomateWebhookURLPattern = `^https:\/\/prod-[0-9]+\.[a-z0-9-]+\.logic\.azure(?:\.us|\.de)?\.com`
 )
 
 // ExpectedEndpointResponseText is the expected success response text when submitting messages, given by webhook endpoint
@@ -40,11 +49,17 @@ var ErrInvalidResponseText = errors.New("message unsuccessful, invalid webhook U
 
 //Interface & Structs--------------------------------------------
 
+// ResponseValidator inspects the http.Response returned by a webhook call and returns a non-nil
+// error if it should be considered unsuccessful. It is responsible for consuming response.Body if
+// its contents are needed to build the error.
+type ResponseValidator func(response *http.Response) error
+
 // MessageSender functions as a client
 type MessageSender interface {
 	HTTPClient() *http.Client
 	//UserAgent() string
 	ValidateWebhook(webhookURL string) error
+	ResponseValidator() ResponseValidator
 }
 
 // messagePreparer prepares messages via marshaling
@@ -69,8 +84,10 @@ type teamsMessage interface {
 type TeamsClient struct {
 	httpClient *http.Client
 	//userAgent                    string
-	//webhookURLValidationPatterns []string <- for multiple patterns
-	//skipWebhookURLValidation bool
+	webhookURLValidationPatterns []string
+	skipWebhookURLValidation     bool
+	responseValidator            ResponseValidator
+	timeout                      time.Duration
 }
 
 //Functions-------------------------------------------------------
@@ -89,24 +106,71 @@ func (client *TeamsClient) HTTPClient() *http.Client {
 	return client.httpClient
 }
 
-// Unused for now
+// AddWebhookURLValidationPattern registers an additional accepted webhook URL pattern, for example
+// a sovereign cloud host or a Power Automate "Workflows for Teams" host, without discarding the
+// patterns already registered. The legacy WebhookURLValidPattern is seeded in on the first call so
+// it stays accepted unless the caller explicitly overrides the pattern set with
+// SetWebhookURLValidationPatterns.
+func (client *TeamsClient) AddWebhookURLValidationPattern(pattern string) *TeamsClient {
+	if len(client.webhookURLValidationPatterns) == 0 {
+		client.webhookURLValidationPatterns = []string{WebhookURLValidPattern}
+	}
+	client.webhookURLValidationPatterns = append(client.webhookURLValidationPatterns, pattern)
+	return client
+}
+
+// SetWebhookURLValidationPatterns replaces the full set of accepted webhook URL patterns
+func (client *TeamsClient) SetWebhookURLValidationPatterns(patterns []string) *TeamsClient {
+	client.webhookURLValidationPatterns = patterns
+	return client
+}
+
+// SkipWebhookURLValidation disables webhook URL pattern validation entirely, for webhook providers
+// whose hosts can't be reasonably expressed as a pattern
+func (client *TeamsClient) SkipWebhookURLValidation(skip bool) *TeamsClient {
+	client.skipWebhookURLValidation = skip
+	return client
+}
+
+// SetResponseValidator overrides the ResponseValidator used to decide whether a webhook call
+// succeeded, for providers like Power Automate whose success response differs from the legacy
+// Office 365 Connector webhook's "1" response text
+func (client *TeamsClient) SetResponseValidator(validator ResponseValidator) *TeamsClient {
+	client.responseValidator = validator
+	return client
+}
+
+// ResponseValidator returns the client's configured ResponseValidator, falling back to
+// DefaultResponseValidator if none has been set
+func (client *TeamsClient) ResponseValidator() ResponseValidator {
+	if client.responseValidator != nil {
+		return client.responseValidator
+	}
+	return DefaultResponseValidator
+}
 
-// setHTTPClient sets a new http.Client value to replace the old one
-// func (client *TeamsClient) setHTTPClient(httpClient *http.Client) *TeamsClient {
-// 	client.httpClient = httpClient
-// 	return client
-// }
+// webhookURLValidationPatternsOrDefault returns the client's configured patterns, falling back to
+// the legacy Office 365 Connector pattern if none have been registered
+func (client *TeamsClient) webhookURLValidationPatternsOrDefault() []string {
+	if len(client.webhookURLValidationPatterns) > 0 {
+		return client.webhookURLValidationPatterns
+	}
+	return []string{WebhookURLValidPattern}
+}
 
-// ValidateWebhook uses the constant WebhookURLValidPattern to ensure the URL is valid, can check for multiple patterns with patterns param
+// ValidateWebhook checks the webhookURL against the client's configured patterns, or the legacy
+// WebhookURLValidPattern if none have been registered
 func (client *TeamsClient) ValidateWebhook(webhookURL string) error {
+	if client.skipWebhookURLValidation {
+		return nil
+	}
+
 	urlLink, err := url.Parse(webhookURL)
 	if err != nil {
 		return fmt.Errorf("could not parse webhook URL %q: %w", webhookURL, err)
 	}
 
-	patterns := []string{WebhookURLValidPattern}
-	//For loop here for multiple patterns
-	for _, thisPattern := range patterns {
+	for _, thisPattern := range client.webhookURLValidationPatternsOrDefault() {
 		match, err := regexp.MatchString(thisPattern, webhookURL)
 		if err != nil {
 			return err
@@ -130,35 +194,65 @@ func prepareRequest(ctxt context.Context, webhookURL string, message io.Reader)
 	return request, nil
 }
 
-// processResponse validates the response from the endpoint after sending a message
-func processResponse(response *http.Response) (string, error) {
+// DefaultResponseValidator accepts the legacy Office 365 Connector webhook's "1" response text, as
+// well as the empty-body HTTP 202 a Power Automate "Workflows for Teams" webhook returns on success
+func DefaultResponseValidator(response *http.Response) error {
 	data, err := io.ReadAll(response.Body)
 	if err != nil {
-		return "", err
+		return err
 	}
-	responseString := string(data)
+	responseString := strings.TrimSpace(string(data))
 
 	//Checks status code and endpoint response
 	if response.StatusCode >= 299 {
-		err := fmt.Errorf("error on code: %v, %q", response.Status, responseString)
-		return "", err
-	} else if responseString != strings.TrimSpace(ExpectedEndpointResponseText) {
-		err := fmt.Errorf("got %q, expected %q: %w", responseString, ExpectedEndpointResponseText, ErrInvalidResponseText)
-		return "", err
-	} else {
-		return responseString, nil
+		return fmt.Errorf("error on code: %v, %q", response.Status, responseString)
 	}
+
+	//Power Automate "Workflows for Teams" webhooks acknowledge success with HTTP 202 and an
+	//empty body, unlike the legacy Office 365 Connector webhook's "1" response text.
+	if response.StatusCode == http.StatusAccepted && responseString == "" {
+		return nil
+	}
+
+	if responseString != ExpectedEndpointResponseText {
+		return fmt.Errorf("got %q, expected %q: %w", responseString, ExpectedEndpointResponseText, ErrInvalidResponseText)
+	}
+
+	return nil
 }
 
-// Send uses a function sendWithContext to send a message with the ability to timeout
+// processResponse runs the given ResponseValidator against the response from the endpoint after
+// sending a message
+func processResponse(response *http.Response, validate ResponseValidator) error {
+	if validate == nil {
+		validate = DefaultResponseValidator
+	}
+	return validate(response)
+}
+
+// Send uses a function sendWithContext to send a message, timing out after the client's
+// configured timeout (WebhookSendTimeout by default, see WithTimeout)
 func (client *TeamsClient) Send(webhookURL string, message teamsMessage) error {
-	// For timeout
-	ctxt, cancel := context.WithTimeout(context.Background(), WebhookSendTimeout)
+	ctxt, cancel := context.WithTimeout(context.Background(), client.timeoutOrDefault())
 	defer cancel()
 
+	return client.SendWithContext(ctxt, webhookURL, message)
+}
+
+// SendWithContext sends a message to the Teams channel using the given webhookURL, letting the
+// caller supply their own cancellation/deadline instead of the client's default timeout
+func (client *TeamsClient) SendWithContext(ctxt context.Context, webhookURL string, message teamsMessage) error {
 	return sendWithContext(ctxt, client, webhookURL, message)
 }
 
+// timeoutOrDefault returns the client's configured timeout, falling back to WebhookSendTimeout
+func (client *TeamsClient) timeoutOrDefault() time.Duration {
+	if client.timeout > 0 {
+		return client.timeout
+	}
+	return WebhookSendTimeout
+}
+
 // sendWithContext sends a message to the Teams channel using the given webhookURL and client
 func sendWithContext(ctxt context.Context, client MessageSender, webhookURL string, message teamsMessage) error {
 	if err := client.ValidateWebhook(webhookURL); err != nil {
@@ -193,11 +287,10 @@ func sendWithContext(ctxt context.Context, client MessageSender, webhookURL stri
 	}()
 
 	//Process the response, check status code to ensure success
-	responseString, err := processResponse(response)
-	if err != nil {
+	if err := processResponse(response, client.ResponseValidator()); err != nil {
 		return fmt.Errorf("failed to process response: %w", err)
 	}
-	log.Printf("Response string: %v\n", responseString)
+	log.Printf("message sent successfully")
 
 	return nil
 }
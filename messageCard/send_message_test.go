@@ -0,0 +1,93 @@
+package messageCard
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestValidateWebhook(t *testing.T) {
+	const legacyURL = "https://foo.webhook.office.com/webhookb2/abc"
+	const powerAutomateURL = "https://prod-00.westus.logic.azure.com/webhook/abc"
+
+	t.Run("legacy url accepted by default", func(t *testing.T) {
+		client := CreateTeamsClient()
+		if err := client.ValidateWebhook(legacyURL); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("AddWebhookURLValidationPattern keeps the legacy pattern accepted", func(t *testing.T) {
+		client := CreateTeamsClient()
+		client.AddWebhookURLValidationPattern(PowerAutomateWebhookURLPattern)
+
+		if err := client.ValidateWebhook(legacyURL); err != nil {
+			t.Fatalf("legacy url should still be accepted, got: %v", err)
+		}
+		if err := client.ValidateWebhook(powerAutomateURL); err != nil {
+			t.Fatalf("power automate url should be accepted, got: %v", err)
+		}
+	})
+
+	t.Run("SetWebhookURLValidationPatterns replaces the pattern set entirely", func(t *testing.T) {
+		client := CreateTeamsClient()
+		client.SetWebhookURLValidationPatterns([]string{PowerAutomateWebhookURLPattern})
+
+		if err := client.ValidateWebhook(legacyURL); err == nil {
+			t.Fatal("legacy url should be rejected once the pattern set has been replaced")
+		}
+		if err := client.ValidateWebhook(powerAutomateURL); err != nil {
+			t.Fatalf("power automate url should be accepted, got: %v", err)
+		}
+	})
+
+	t.Run("SkipWebhookURLValidation disables validation entirely", func(t *testing.T) {
+		client := CreateTeamsClient()
+		client.SkipWebhookURLValidation(true)
+
+		if err := client.ValidateWebhook("not-a-webhook-url-at-all"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("unrecognized url rejected", func(t *testing.T) {
+		client := CreateTeamsClient()
+		if err := client.ValidateWebhook("https://example.com/webhook"); err == nil {
+			t.Fatal("expected an error for an unrecognized webhook host")
+		}
+	})
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Status:     http.StatusText(statusCode),
+		Body:       io.NopCloser(bytes.NewBufferString(body)),
+	}
+}
+
+func TestDefaultResponseValidator(t *testing.T) {
+	tests := []struct {
+		name       string
+		response   *http.Response
+		expectFail bool
+	}{
+		{"legacy success text", newResponse(http.StatusOK, "1"), false},
+		{"power automate empty accepted", newResponse(http.StatusAccepted, ""), false},
+		{"error status code", newResponse(http.StatusInternalServerError, "1"), true},
+		{"unexpected response text", newResponse(http.StatusOK, "0"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := DefaultResponseValidator(tt.response)
+			if tt.expectFail && err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			if !tt.expectFail && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
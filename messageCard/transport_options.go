@@ -0,0 +1,64 @@
+// Created by Johan Lee - May 2024
+
+/* This file holds the TeamsClient options that configure its outbound HTTP transport: a custom
+http.Client, a corporate proxy, a custom TLS config for enterprise CA bundles, and a send timeout.
+These are needed in enterprise deployments that route outbound HTTPS through a proxy, as the
+sample "compassion" domain webhooks in this repo suggest. */
+
+package messageCard
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// WithHTTPClient replaces the client's underlying http.Client, e.g. to share a client already
+// configured with custom transport, proxy, or TLS settings
+func (client *TeamsClient) WithHTTPClient(httpClient *http.Client) *TeamsClient {
+	client.httpClient = httpClient
+	return client
+}
+
+// WithProxy routes the client's outbound requests through the given proxy URL. Unlike the other
+// With* options it returns only an error, not *TeamsClient - a malformed proxyURL is a caller bug
+// that must be checked before continuing, and this signature deliberately can't be chained past.
+func (client *TeamsClient) WithProxy(proxyURL string) error {
+	parsedURL, err := url.Parse(proxyURL)
+	if err != nil {
+		return fmt.Errorf("could not parse proxy URL %q: %w", proxyURL, err)
+	}
+
+	transport := client.transport()
+	transport.Proxy = http.ProxyURL(parsedURL)
+	client.httpClient.Transport = transport
+
+	return nil
+}
+
+// WithTLSConfig sets a custom TLS config on the client's transport, e.g. to trust a custom CA
+// bundle required by a corporate proxy
+func (client *TeamsClient) WithTLSConfig(tlsConfig *tls.Config) *TeamsClient {
+	transport := client.transport()
+	transport.TLSClientConfig = tlsConfig
+	client.httpClient.Transport = transport
+
+	return client
+}
+
+// WithTimeout overrides the client's send timeout, replacing the hardcoded WebhookSendTimeout
+func (client *TeamsClient) WithTimeout(d time.Duration) *TeamsClient {
+	client.timeout = d
+	return client
+}
+
+// transport returns the client's http.Transport, creating one if the client doesn't already have
+// a *http.Transport installed
+func (client *TeamsClient) transport() *http.Transport {
+	if transport, ok := client.httpClient.Transport.(*http.Transport); ok && transport != nil {
+		return transport
+	}
+	return &http.Transport{}
+}
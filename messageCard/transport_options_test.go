@@ -0,0 +1,23 @@
+package messageCard
+
+import "testing"
+
+func TestWithProxy(t *testing.T) {
+	t.Run("malformed proxy URL returns an error", func(t *testing.T) {
+		client := CreateTeamsClient()
+		if err := client.WithProxy("://not-a-url"); err == nil {
+			t.Fatal("expected an error for a malformed proxy URL, got nil")
+		}
+	})
+
+	t.Run("valid proxy URL configures the transport", func(t *testing.T) {
+		client := CreateTeamsClient()
+		if err := client.WithProxy("http://proxy.internal:8080"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if client.transport().Proxy == nil {
+			t.Fatal("expected transport.Proxy to be set")
+		}
+	})
+}
@@ -0,0 +1,91 @@
+// Created by Johan Lee - April 2024
+
+/* This file loads the set of configured Notifiers from YAML, so operators can add or remove
+alerting sinks without recompiling. */
+
+package notify
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// NotifierConfig describes a single configured notifier backend
+type NotifierConfig struct {
+	Type       string `yaml:"type"`
+	Name       string `yaml:"name"`
+	WebhookURL string `yaml:"webhookUrl"`
+}
+
+// Config is the top-level notify subsystem configuration
+type Config struct {
+	Notifiers []NotifierConfig `yaml:"notifiers"`
+	Retries   int              `yaml:"retries"`
+	Backoff   time.Duration    `yaml:"backoff"`
+}
+
+// LoadConfig decodes a Config from YAML
+func LoadConfig(r io.Reader) (*Config, error) {
+	var cfg Config
+	if err := yaml.NewDecoder(r).Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode notify config: %w", err)
+	}
+	return &cfg, nil
+}
+
+// LoadConfigFile decodes a Config from a YAML file on disk
+func LoadConfigFile(path string) (*Config, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open notify config %q: %w", path, err)
+	}
+	defer file.Close()
+
+	return LoadConfig(file)
+}
+
+// BuildDispatcher constructs every Notifier described by the Config and wires them into a
+// Dispatcher using the Config's retry/backoff settings. A zero (or unset) retries or backoff value
+// keeps the Dispatcher's DefaultRetries / DefaultBackoff.
+func BuildDispatcher(cfg *Config) (*Dispatcher, error) {
+	notifiers := make([]Notifier, 0, len(cfg.Notifiers))
+	for _, nc := range cfg.Notifiers {
+		notifier, err := newNotifier(nc)
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, notifier)
+	}
+
+	dispatcher := NewDispatcher(notifiers...)
+	if cfg.Retries > 0 {
+		dispatcher.WithRetries(cfg.Retries)
+	}
+	if cfg.Backoff > 0 {
+		dispatcher.WithBackoff(cfg.Backoff)
+	}
+
+	return dispatcher, nil
+}
+
+// newNotifier constructs the Notifier described by a single NotifierConfig entry
+func newNotifier(nc NotifierConfig) (Notifier, error) {
+	switch nc.Type {
+	case "msteams":
+		return NewMSTeamsNotifier(nc.Name, nc.WebhookURL), nil
+	case "slack":
+		return NewSlackNotifier(nc.Name, nc.WebhookURL), nil
+	case "discord":
+		return NewDiscordNotifier(nc.Name, nc.WebhookURL), nil
+	case "mattermost":
+		return NewMattermostNotifier(nc.Name, nc.WebhookURL), nil
+	case "generic-http":
+		return NewGenericHTTPNotifier(nc.Name, nc.WebhookURL), nil
+	default:
+		return nil, fmt.Errorf("unknown notifier type %q", nc.Type)
+	}
+}
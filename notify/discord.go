@@ -0,0 +1,79 @@
+// Created by Johan Lee - April 2024
+
+/* This file implements the Discord backend for the notify subsystem, rendering an Event as a
+Discord embed and posting it to an incoming webhook. */
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// discordPayload is the top-level body Discord's incoming webhooks expect
+type discordPayload struct {
+	Embeds []discordEmbed `json:"embeds"`
+}
+
+// discordEmbed is a single Discord embed
+type discordEmbed struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Color       int            `json:"color,omitempty"`
+	Fields      []discordField `json:"fields,omitempty"`
+}
+
+// discordField is a name/value pair rendered within an embed
+type discordField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+// DiscordNotifier posts Events to a Discord incoming webhook as embeds
+type DiscordNotifier struct {
+	name       string
+	webhookURL string
+	httpClient *http.Client
+}
+
+// NewDiscordNotifier creates a Notifier that posts rendered Events to a Discord webhook
+func NewDiscordNotifier(name, webhookURL string) *DiscordNotifier {
+	return &DiscordNotifier{
+		name:       name,
+		webhookURL: webhookURL,
+		httpClient: &http.Client{},
+	}
+}
+
+// Name returns the configured notifier name
+func (n *DiscordNotifier) Name() string {
+	return n.name
+}
+
+// Send renders the Event as a Discord embed and posts it to the Discord webhook
+func (n *DiscordNotifier) Send(ctx context.Context, event Event) error {
+	fields := make([]discordField, 0, len(event.Fields))
+	for name, value := range event.Fields {
+		fields = append(fields, discordField{Name: name, Value: value, Inline: true})
+	}
+
+	payload := discordPayload{
+		Embeds: []discordEmbed{
+			{
+				Title:       event.Title,
+				Description: event.Text,
+				Fields:      fields,
+			},
+		},
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal discord payload: %w", err)
+	}
+
+	return postJSON(ctx, n.httpClient, n.webhookURL, body)
+}
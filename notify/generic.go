@@ -0,0 +1,45 @@
+// Created by Johan Lee - April 2024
+
+/* This file implements a generic-http backend for the notify subsystem, for sinks that don't
+speak any particular chat platform's API but can accept a plain JSON POST - internal dashboards,
+ticketing webhooks, and the like. */
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GenericHTTPNotifier posts the raw Event as JSON to an arbitrary webhook URL
+type GenericHTTPNotifier struct {
+	name       string
+	webhookURL string
+	httpClient *http.Client
+}
+
+// NewGenericHTTPNotifier creates a Notifier that posts the raw Event as JSON to a webhook URL
+func NewGenericHTTPNotifier(name, webhookURL string) *GenericHTTPNotifier {
+	return &GenericHTTPNotifier{
+		name:       name,
+		webhookURL: webhookURL,
+		httpClient: &http.Client{},
+	}
+}
+
+// Name returns the configured notifier name
+func (n *GenericHTTPNotifier) Name() string {
+	return n.name
+}
+
+// Send posts the Event as JSON to the configured webhook URL
+func (n *GenericHTTPNotifier) Send(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return postJSON(ctx, n.httpClient, n.webhookURL, body)
+}
@@ -0,0 +1,54 @@
+// Created by Johan Lee - April 2024
+
+/* This file implements the Mattermost backend for the notify subsystem. Mattermost incoming
+webhooks accept the same simple {text} JSON body Slack originally used, before Slack moved on to
+Block Kit. */
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// mattermostPayload is the body Mattermost's incoming webhooks expect
+type mattermostPayload struct {
+	Text string `json:"text"`
+}
+
+// MattermostNotifier posts Events to a Mattermost incoming webhook
+type MattermostNotifier struct {
+	name       string
+	webhookURL string
+	httpClient *http.Client
+}
+
+// NewMattermostNotifier creates a Notifier that posts rendered Events to a Mattermost webhook
+func NewMattermostNotifier(name, webhookURL string) *MattermostNotifier {
+	return &MattermostNotifier{
+		name:       name,
+		webhookURL: webhookURL,
+		httpClient: &http.Client{},
+	}
+}
+
+// Name returns the configured notifier name
+func (n *MattermostNotifier) Name() string {
+	return n.name
+}
+
+// Send renders the Event as Mattermost markdown text and posts it to the Mattermost webhook
+func (n *MattermostNotifier) Send(ctx context.Context, event Event) error {
+	payload := mattermostPayload{
+		Text: fmt.Sprintf("##### %s\n%s", event.Title, renderText(event)),
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal mattermost payload: %w", err)
+	}
+
+	return postJSON(ctx, n.httpClient, n.webhookURL, body)
+}
@@ -0,0 +1,59 @@
+// Created by Johan Lee - April 2024
+
+/* This file adapts the existing MessageCard client into a notify.Notifier, so Microsoft Teams
+becomes just one more configured backend instead of the only destination a message can go to. */
+
+package notify
+
+import (
+	"context"
+	"strings"
+
+	messagecard "teams_listening_service/messageCard"
+)
+
+// MSTeamsNotifier renders Events as Office 365 Connector MessageCards and sends them to a
+// Microsoft Teams incoming webhook
+type MSTeamsNotifier struct {
+	name       string
+	webhookURL string
+	client     *messagecard.TeamsClient
+}
+
+// NewMSTeamsNotifier creates a Notifier that posts rendered Events to a Teams webhook
+func NewMSTeamsNotifier(name, webhookURL string) *MSTeamsNotifier {
+	return &MSTeamsNotifier{
+		name:       name,
+		webhookURL: webhookURL,
+		client:     messagecard.CreateTeamsClient(),
+	}
+}
+
+// Name returns the configured notifier name
+func (n *MSTeamsNotifier) Name() string {
+	return n.name
+}
+
+// Send renders the Event as a MessageCard and posts it to the Teams webhook
+func (n *MSTeamsNotifier) Send(ctx context.Context, event Event) error {
+	card := messagecard.CreateMessageCard()
+	card.Title = event.Title
+	card.Text = renderText(event)
+	card.Color = severityColor(event.Severity)
+
+	return n.client.SendWithContext(ctx, n.webhookURL, card)
+}
+
+// severityColor maps an Event's severity to the MessageCard's accent color
+func severityColor(severity string) string {
+	switch strings.ToLower(severity) {
+	case "critical", "error":
+		return "FF0000"
+	case "warning":
+		return "FFA500"
+	case "info":
+		return "0076D7"
+	default:
+		return ""
+	}
+}
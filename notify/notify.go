@@ -0,0 +1,115 @@
+// Created by Johan Lee - April 2024
+
+/* This file defines the notify subsystem: a Notifier interface that any alerting backend can
+implement, and a Dispatcher that fans a single Event out to every configured Notifier with
+per-notifier retry/backoff, aggregating whatever errors come back. It is the generalization of
+what used to be a single hardcoded path straight to a Microsoft Teams webhook. */
+
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Event is the backend-agnostic description of something worth notifying about
+type Event struct {
+	Title    string
+	Text     string
+	Severity string
+	Fields   map[string]string
+	Links    []string
+}
+
+// Notifier sends an Event to a single alerting backend
+type Notifier interface {
+	Send(ctx context.Context, event Event) error
+	Name() string
+}
+
+// DefaultRetries is the number of additional attempts a Dispatcher makes after an initial failure
+const DefaultRetries = 2
+
+// DefaultBackoff is the initial delay between retries, doubled after each attempt
+const DefaultBackoff = 500 * time.Millisecond
+
+// Dispatcher fans an Event out to a set of Notifiers, retrying each one independently
+type Dispatcher struct {
+	notifiers []Notifier
+	retries   int
+	backoff   time.Duration
+}
+
+// NewDispatcher creates a Dispatcher with the default retry/backoff settings
+func NewDispatcher(notifiers ...Notifier) *Dispatcher {
+	return &Dispatcher{
+		notifiers: notifiers,
+		retries:   DefaultRetries,
+		backoff:   DefaultBackoff,
+	}
+}
+
+// WithRetries overrides the number of retry attempts made per notifier
+func (d *Dispatcher) WithRetries(retries int) *Dispatcher {
+	d.retries = retries
+	return d
+}
+
+// WithBackoff overrides the initial delay between retries
+func (d *Dispatcher) WithBackoff(backoff time.Duration) *Dispatcher {
+	d.backoff = backoff
+	return d
+}
+
+// Dispatch sends the Event to every configured Notifier concurrently and returns an aggregated
+// error for whichever notifiers failed after exhausting their retries. A nil error means every
+// notifier succeeded. Notifiers are independent of each other, so a slow or down notifier's
+// retry/backoff never delays delivery to the rest.
+func (d *Dispatcher) Dispatch(ctx context.Context, event Event) error {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []error
+
+	for _, notifier := range d.notifiers {
+		wg.Add(1)
+		go func(notifier Notifier) {
+			defer wg.Done()
+			if err := d.sendWithRetry(ctx, notifier, event); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("%s: %w", notifier.Name(), err))
+				mu.Unlock()
+			}
+		}(notifier)
+	}
+
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// sendWithRetry sends the Event via the given Notifier, retrying with exponential backoff
+func (d *Dispatcher) sendWithRetry(ctx context.Context, notifier Notifier, event Event) error {
+	backoff := d.backoff
+	var err error
+
+	for attempt := 0; attempt <= d.retries; attempt++ {
+		if err = notifier.Send(ctx, event); err == nil {
+			return nil
+		}
+
+		if attempt == d.retries {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+
+	return err
+}
@@ -0,0 +1,86 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fakeNotifier struct {
+	name    string
+	failFor int32 // number of calls to fail before succeeding
+	calls   int32
+}
+
+func (f *fakeNotifier) Name() string { return f.name }
+
+func (f *fakeNotifier) Send(ctx context.Context, event Event) error {
+	n := atomic.AddInt32(&f.calls, 1)
+	if n <= atomic.LoadInt32(&f.failFor) {
+		return errors.New("simulated failure")
+	}
+	return nil
+}
+
+func TestDispatcherRetriesUntilSuccess(t *testing.T) {
+	notifier := &fakeNotifier{name: "flaky", failFor: 2}
+	dispatcher := NewDispatcher(notifier).WithRetries(2).WithBackoff(time.Millisecond)
+
+	if err := dispatcher.Dispatch(context.Background(), Event{Title: "test"}); err != nil {
+		t.Fatalf("expected the notifier to succeed on its final retry, got: %v", err)
+	}
+	if got := atomic.LoadInt32(&notifier.calls); got != 3 {
+		t.Fatalf("expected 3 calls (1 initial + 2 retries), got %d", got)
+	}
+}
+
+func TestDispatcherAggregatesFailures(t *testing.T) {
+	failing := &fakeNotifier{name: "always-fails", failFor: 100}
+	succeeding := &fakeNotifier{name: "always-succeeds", failFor: 0}
+	dispatcher := NewDispatcher(failing, succeeding).WithRetries(0).WithBackoff(time.Millisecond)
+
+	err := dispatcher.Dispatch(context.Background(), Event{Title: "test"})
+	if err == nil {
+		t.Fatal("expected an aggregated error for the failing notifier")
+	}
+	if atomic.LoadInt32(&succeeding.calls) != 1 {
+		t.Fatalf("expected the succeeding notifier to still be called once, got %d calls", succeeding.calls)
+	}
+}
+
+func TestDispatcherRunsNotifiersConcurrently(t *testing.T) {
+	const notifierCount = 5
+	const sendDelay = 50 * time.Millisecond
+
+	notifiers := make([]Notifier, notifierCount)
+	for i := 0; i < notifierCount; i++ {
+		notifiers[i] = &slowNotifier{name: "n", delay: sendDelay}
+	}
+	dispatcher := NewDispatcher(notifiers...)
+
+	start := time.Now()
+	if err := dispatcher.Dispatch(context.Background(), Event{Title: "test"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	elapsed := time.Since(start)
+
+	// If notifiers ran sequentially this would take notifierCount*sendDelay; concurrently it
+	// should take roughly one sendDelay.
+	if elapsed >= notifierCount*sendDelay {
+		t.Fatalf("Dispatch took %v, expected notifiers to run concurrently (~%v)", elapsed, sendDelay)
+	}
+}
+
+type slowNotifier struct {
+	name  string
+	delay time.Duration
+}
+
+func (s *slowNotifier) Name() string { return s.name }
+
+func (s *slowNotifier) Send(ctx context.Context, event Event) error {
+	time.Sleep(s.delay)
+	return nil
+}
@@ -0,0 +1,68 @@
+// Created by Johan Lee - April 2024
+
+/* This file implements the Slack backend for the notify subsystem, rendering an Event as Slack
+Block Kit blocks and posting them to an incoming webhook. */
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// slackPayload is the top-level body Slack's incoming webhooks expect
+type slackPayload struct {
+	Blocks []slackBlock `json:"blocks"`
+}
+
+// slackBlock is a single Block Kit block
+type slackBlock struct {
+	Type string     `json:"type"`
+	Text *slackText `json:"text,omitempty"`
+}
+
+// slackText is a Block Kit text object
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// SlackNotifier posts Events to a Slack incoming webhook using Block Kit formatting
+type SlackNotifier struct {
+	name       string
+	webhookURL string
+	httpClient *http.Client
+}
+
+// NewSlackNotifier creates a Notifier that posts rendered Events to a Slack webhook
+func NewSlackNotifier(name, webhookURL string) *SlackNotifier {
+	return &SlackNotifier{
+		name:       name,
+		webhookURL: webhookURL,
+		httpClient: &http.Client{},
+	}
+}
+
+// Name returns the configured notifier name
+func (n *SlackNotifier) Name() string {
+	return n.name
+}
+
+// Send renders the Event as Slack blocks and posts it to the Slack webhook
+func (n *SlackNotifier) Send(ctx context.Context, event Event) error {
+	payload := slackPayload{
+		Blocks: []slackBlock{
+			{Type: "header", Text: &slackText{Type: "plain_text", Text: event.Title}},
+			{Type: "section", Text: &slackText{Type: "mrkdwn", Text: renderText(event)}},
+		},
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal slack payload: %w", err)
+	}
+
+	return postJSON(ctx, n.httpClient, n.webhookURL, body)
+}
@@ -0,0 +1,48 @@
+// Created by Johan Lee - April 2024
+
+/* This file holds the shared HTTP plumbing used by the webhook-style notifiers (Slack, Discord,
+Mattermost, generic-http), all of which just POST a JSON body to a webhook URL and expect a 2xx
+response. */
+
+package notify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// postJSON sends a JSON body to a webhook URL and treats any 2xx response as success
+func postJSON(ctx context.Context, client *http.Client, webhookURL string, body []byte) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to prepare request: %w", err)
+	}
+	request.Header.Add("Content-Type", "application/json;charset=utf-8")
+
+	response, err := client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 300 {
+		return fmt.Errorf("error on code: %v", response.Status)
+	}
+
+	return nil
+}
+
+// renderText flattens an Event's fields and links into a single plain-text body, used by the
+// notifiers that send an Event as one text body (Slack, Mattermost, Microsoft Teams)
+func renderText(event Event) string {
+	text := event.Text
+	for key, value := range event.Fields {
+		text += fmt.Sprintf("\n\n*%s*: %s", key, value)
+	}
+	for _, link := range event.Links {
+		text += fmt.Sprintf("\n\n%s", link)
+	}
+	return text
+}